@@ -4,6 +4,7 @@ package main
 
 import (
 	"fmt"
+	"math"
 	"os"
 )
 
@@ -55,3 +56,84 @@ type Rectangle struct {
 	width  float64
 	height float64
 }
+
+// solid
+
+type Solid interface {
+	volume() float64
+	surfaceArea() float64
+}
+
+type Sphere struct {
+	radius float64
+}
+
+func (s Sphere) volume() float64 {
+	return 4.0 / 3.0 * math.Pi * s.radius * s.radius * s.radius
+}
+
+func (s Sphere) surfaceArea() float64 {
+	return 4 * math.Pi * s.radius * s.radius
+}
+
+type Cube struct {
+	side float64
+}
+
+func (c Cube) volume() float64 {
+	return c.side * c.side * c.side
+}
+
+func (c Cube) surfaceArea() float64 {
+	return 6 * c.side * c.side
+}
+
+type Cylinder struct {
+	radius float64
+	height float64
+}
+
+func (c Cylinder) volume() float64 {
+	return math.Pi * c.radius * c.radius * c.height
+}
+
+func (c Cylinder) surfaceArea() float64 {
+	return 2 * math.Pi * c.radius * (c.radius + c.height)
+}
+
+// polygon
+
+type Point struct {
+	x float64
+	y float64
+}
+
+type Polygon struct {
+	points []Point
+}
+
+func (p Polygon) area() float64 {
+	n := len(p.points)
+	if n < 3 {
+		return 0
+	}
+	sum := 0.0
+	for i := 0; i < n; i++ {
+		a, b := p.points[i], p.points[(i+1)%n]
+		sum += a.x*b.y - b.x*a.y
+	}
+	return math.Abs(sum) / 2
+}
+
+func (p Polygon) perimeter() float64 {
+	n := len(p.points)
+	if n < 2 {
+		return 0
+	}
+	sum := 0.0
+	for i := 0; i < n; i++ {
+		a, b := p.points[i], p.points[(i+1)%n]
+		sum += math.Hypot(b.x-a.x, b.y-a.y)
+	}
+	return sum
+}